@@ -1,5 +1,8 @@
+#include <algorithm>
 #include <fstream>
 #include <iomanip>
+#include <set>
+#include <vector>
 
 #include "nlohmann/json.hpp"
 #include "subprocess.hpp"
@@ -20,13 +23,63 @@ string trim(string in) {
   return in;
 }
 
+// Returns the target in the graph with the given label, or nullptr if it isn't in there
+// (e.g. because it's in a subrepo).
+const json* find_target(const json& graph, const string& label) {
+  const auto idx = label.find(':');
+  if (label.rfind("//", 0) != 0 || idx == string::npos) {
+    return nullptr;
+  }
+  const auto pkg = graph["packages"].find(label.substr(2, idx - 2));
+  if (pkg == graph["packages"].end()) {
+    return nullptr;
+  }
+  const auto target = (*pkg)["targets"].find(label.substr(idx + 1));
+  return target == (*pkg)["targets"].end() ? nullptr : &*target;
+}
+
+// Collects the include directories and defines that this target and its transitive dependencies
+// apply via labels. The build applies these in a pre-build function, so they aren't in the
+// commands the graph gives us.
+void collect_flags(const json& graph, const json& target, std::set<string>& seen, std::vector<string>& flags) {
+  if (target.contains("labels")) {
+    for (const auto& l : target["labels"]) {
+      const auto label = l.get<string>();
+      string flag;
+      if (label.rfind("cc:inc:", 0) == 0) {
+        flag = "-isystem " + label.substr(7);
+      } else if (label.rfind("cc:def:", 0) == 0) {
+        flag = "-D" + label.substr(7);
+      } else {
+        continue;
+      }
+      if (std::find(flags.begin(), flags.end(), flag) == flags.end()) {
+        flags.push_back(flag);
+      }
+    }
+  }
+  if (target.contains("deps")) {
+    for (const auto& d : target["deps"]) {
+      const auto dep = d.get<string>();
+      if (seen.insert(dep).second) {
+        if (const json* t = find_target(graph, dep)) {
+          collect_flags(graph, *t, seen, flags);
+        }
+      }
+    }
+  }
+}
+
 int main(int argc, const char* argv[]) {
   // Get the repo root from plz (not necessarily the same as the cwd).
   auto rbuf = subprocess::check_output({"plz", "query", "reporoot"});
   const string dir = trim(string(rbuf.buf.begin(), rbuf.buf.end()));
   const string genDir = dir + "/plz-out/gen";
 
-  auto obuf = subprocess::check_output({"plz", "query", "graph", "-c", "dbg", "--profile", "clang"});
+  // Any arguments are passed through, so it's possible to limit this to particular targets.
+  std::vector<string> query = {"plz", "query", "graph", "-c", "dbg", "--profile", "clang"};
+  query.insert(query.end(), argv + 1, argv + argc);
+  auto obuf = subprocess::check_output(query);
   auto graph = json::parse(obuf.buf.begin(), obuf.buf.end());
 
   auto out = json::array();
@@ -36,13 +89,26 @@ int main(int argc, const char* argv[]) {
       // we consider relevant. Maybe we should check labels as well.
       if (target.contains("command") && target.contains("srcs") && target["srcs"].contains("srcs")) {
         auto cmd = target["command"].get<string>();
-        if (cmd.rfind("$TOOLS_CC", 0) == 0) {  // no starts_with until C++20 :(
+        // Reproducible builds set this for the compiler, but it isn't part of the command itself.
+        const string epoch = "SOURCE_DATE_EPOCH=0 ";
+        if (cmd.rfind(epoch, 0) == 0) {
+          cmd = cmd.substr(epoch.size());
+        }
+        // Layering checks compile the same sources again, which would just be duplicates.
+        const bool layering = cmd.find(" -fsyntax-only ") != string::npos;
+        if (cmd.rfind("$TOOLS_CC", 0) == 0 && !layering) {  // no starts_with until C++20 :(
           // Strip the end parts where we archive the output
           auto idx = cmd.find(" && ");
           if (idx != string::npos) {
             cmd.resize(idx);
             cmd = trim(cmd);
           }
+          std::set<string> seen;
+          std::vector<string> flags;
+          collect_flags(graph, target, seen, flags);
+          for (const auto& flag : flags) {
+            cmd += " " + flag;
+          }
           for (const auto& src : target["srcs"]["srcs"]) {
             // Hardcode the filenames in place of variables
             string c = replace(cmd, "${SRCS_SRCS}", src);
@@ -0,0 +1,9 @@
+#include "test/coverage/parity.h"
+
+namespace plz {
+
+bool is_even(int n) {
+    return n % 2 == 0;
+}
+
+}  // namespace plz
@@ -0,0 +1,10 @@
+#ifndef TEST_COVERAGE_PARITY_H
+#define TEST_COVERAGE_PARITY_H
+
+namespace plz {
+
+bool is_even(int n);
+
+}  // namespace plz
+
+#endif  // TEST_COVERAGE_PARITY_H
@@ -0,0 +1,12 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/coverage/parity.h"
+
+namespace plz {
+
+TEST(Parity) {
+    CHECK(is_even(2));
+    CHECK(!is_even(3));
+}
+
+}  // namespace plz
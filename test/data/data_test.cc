@@ -0,0 +1,7 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/data/greeting.h"
+
+TEST(LibraryData) {
+  CHECK_EQUAL("Hello", Greeting());
+}
@@ -0,0 +1,13 @@
+#include "test/data/greeting.h"
+
+#include <stdlib.h>
+
+#include <fstream>
+
+std::string Greeting() {
+    const char* root = getenv("RUNFILES_DIR");
+    std::ifstream f(std::string(root ? root : ".") + "/test/data/greeting.txt");
+    std::string greeting;
+    std::getline(f, greeting);
+    return greeting;
+}
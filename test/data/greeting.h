@@ -0,0 +1,9 @@
+#ifndef TEST_DATA_GREETING_H
+#define TEST_DATA_GREETING_H
+
+#include <string>
+
+// Returns the greeting read from this library's data file.
+std::string Greeting();
+
+#endif  // TEST_DATA_GREETING_H
@@ -0,0 +1,14 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/flex_bison/sum.h"
+
+TEST(ParsesSum) {
+  int result = 0;
+  CHECK_EQUAL(0, parse_sum("1 + 2 + 39", &result));
+  CHECK_EQUAL(42, result);
+}
+
+TEST(RejectsInvalidInput) {
+  int result = 0;
+  CHECK(parse_sum("1 + + 2", &result) != 0);
+}
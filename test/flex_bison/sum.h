@@ -0,0 +1,15 @@
+#ifndef TEST_FLEX_BISON_SUM_H
+#define TEST_FLEX_BISON_SUM_H
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+// Parses a sum of integers like "1 + 2 + 3". Returns 0 and sets result on success.
+int parse_sum(const char* s, int* result);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif  // TEST_FLEX_BISON_SUM_H
@@ -0,0 +1,24 @@
+%{
+#include <stdio.h>
+
+int yylex(void);
+void yyerror(int* result, const char* msg);
+%}
+
+%parse-param { int* result }
+%token NUMBER
+
+%%
+
+input: expr { *result = $1; } ;
+
+expr: NUMBER { $$ = $1; }
+    | expr '+' NUMBER { $$ = $1 + $3; }
+    ;
+
+%%
+
+void yyerror(int* result, const char* msg) {
+    (void)result;
+    fprintf(stderr, "%s\n", msg);
+}
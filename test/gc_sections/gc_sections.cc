@@ -0,0 +1,12 @@
+// Nothing references unused_function, so linking with gc_sections should discard it.
+int unused_function(int x) {
+  return x * 42;
+}
+
+int used_function(int x) {
+  return x + 1;
+}
+
+int main(int argc, char** argv) {
+  return used_function(argc) == 2 ? 0 : 1;
+}
@@ -0,0 +1,8 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "answer.hpp"
+
+TEST(HeaderOnlyAnswer) {
+  CHECK_EQUAL(42, GetAnswer());
+  CHECK_EQUAL("deep_thought", GetAnswerName());
+}
@@ -0,0 +1,14 @@
+#ifndef TEST_HEADER_ONLY_ANSWER_HPP
+#define TEST_HEADER_ONLY_ANSWER_HPP
+
+#include <string>
+
+inline int GetAnswer() {
+  return 42;
+}
+
+inline std::string GetAnswerName() {
+  return ANSWER_NAME;
+}
+
+#endif  // TEST_HEADER_ONLY_ANSWER_HPP
@@ -0,0 +1,5 @@
+#include "test/implementation_deps/hidden.h"
+
+int HiddenAnswer() {
+    return 42;
+}
@@ -0,0 +1,6 @@
+#ifndef TEST_IMPLEMENTATION_DEPS_HIDDEN_H
+#define TEST_IMPLEMENTATION_DEPS_HIDDEN_H
+
+int HiddenAnswer();
+
+#endif  // TEST_IMPLEMENTATION_DEPS_HIDDEN_H
@@ -0,0 +1,12 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/implementation_deps/public.h"
+
+#ifdef HIDDEN
+#error "implementation_deps' defines shouldn't be exported"
+#endif
+
+TEST(PublicAnswer) {
+  // Still links, since the hidden library is linked in even though we can't see its headers.
+  CHECK_EQUAL(42, PublicAnswer());
+}
@@ -0,0 +1,10 @@
+#include "test/implementation_deps/public.h"
+#include "test/implementation_deps/hidden.h"
+
+#ifndef HIDDEN
+#error "implementation_deps' defines should apply to this library"
+#endif
+
+int PublicAnswer() {
+    return HiddenAnswer();
+}
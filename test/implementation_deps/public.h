@@ -0,0 +1,6 @@
+#ifndef TEST_IMPLEMENTATION_DEPS_PUBLIC_H
+#define TEST_IMPLEMENTATION_DEPS_PUBLIC_H
+
+int PublicAnswer();
+
+#endif  // TEST_IMPLEMENTATION_DEPS_PUBLIC_H
@@ -0,0 +1,7 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/import/prebuilt.h"
+
+TEST(PrebuiltAnswer) {
+  CHECK_EQUAL(42, GetPrebuiltAnswer());
+}
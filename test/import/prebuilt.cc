@@ -0,0 +1,3 @@
+int GetPrebuiltAnswer() {
+  return 42;
+}
@@ -0,0 +1,6 @@
+#ifndef TEST_IMPORT_PREBUILT_H
+#define TEST_IMPORT_PREBUILT_H
+
+int GetPrebuiltAnswer();
+
+#endif  // TEST_IMPORT_PREBUILT_H
@@ -0,0 +1,7 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "greeting/greeting.h"
+
+TEST(Greeting) {
+  CHECK_EQUAL("hello", Greeting());
+}
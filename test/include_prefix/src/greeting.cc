@@ -0,0 +1,5 @@
+#include "greeting/greeting.h"
+
+std::string Greeting() {
+    return "hello";
+}
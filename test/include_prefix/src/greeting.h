@@ -0,0 +1,8 @@
+#ifndef TEST_INCLUDE_PREFIX_GREETING_H
+#define TEST_INCLUDE_PREFIX_GREETING_H
+
+#include <string>
+
+std::string Greeting();
+
+#endif  // TEST_INCLUDE_PREFIX_GREETING_H
@@ -0,0 +1,15 @@
+#include "test/layering/layered.h"
+
+#include "test/lib2.h"
+
+namespace plz {
+
+int get_layered_number() {
+    return get_number_2()
+#define NUMBER(n) + n
+#include "test/layering/layered.def"
+#undef NUMBER
+    ;
+}
+
+}  // namespace plz
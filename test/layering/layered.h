@@ -0,0 +1,10 @@
+#ifndef TEST_LAYERING_LAYERED_H
+#define TEST_LAYERING_LAYERED_H
+
+namespace plz {
+
+int get_layered_number();
+
+}  // namespace plz
+
+#endif  // TEST_LAYERING_LAYERED_H
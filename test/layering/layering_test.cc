@@ -0,0 +1,11 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/layering/layered.h"
+
+namespace plz {
+
+TEST(LayeredNumber) {
+    CHECK_EQUAL(216, get_layered_number());
+}
+
+}  // namespace plz
@@ -0,0 +1,6 @@
+#include "test/link_group/a.h"
+#include "test/link_group/b.h"
+
+int AValue() {
+    return BValue() + 1;
+}
@@ -0,0 +1,8 @@
+#ifndef TEST_LINK_GROUP_A_H
+#define TEST_LINK_GROUP_A_H
+
+int AValue();
+
+int ABase();
+
+#endif  // TEST_LINK_GROUP_A_H
@@ -0,0 +1,7 @@
+#include "test/link_group/a.h"
+
+// This is in its own object file so it's only pulled out of liba.a if something needs it,
+// which only happens after libb.a has been seen.
+int ABase() {
+    return 40;
+}
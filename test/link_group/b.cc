@@ -0,0 +1,8 @@
+#include "test/link_group/b.h"
+
+// Defined in :a, which we can't depend on.
+int ABase();
+
+int BValue() {
+    return ABase() + 1;
+}
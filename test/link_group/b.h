@@ -0,0 +1,6 @@
+#ifndef TEST_LINK_GROUP_B_H
+#define TEST_LINK_GROUP_B_H
+
+int BValue();
+
+#endif  // TEST_LINK_GROUP_B_H
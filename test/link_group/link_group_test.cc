@@ -0,0 +1,7 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/link_group/a.h"
+
+TEST(CyclicLibraries) {
+  CHECK_EQUAL(42, AValue());
+}
@@ -0,0 +1,12 @@
+#include "test/mixed/mixed.h"
+
+#ifdef __cplusplus
+#error "C source compiled as C++"
+#endif
+#if !defined(C_ONLY) || defined(CXX_ONLY)
+#error "C source compiled with the wrong flags"
+#endif
+
+int CAnswer(void) {
+    return 42;
+}
@@ -0,0 +1,9 @@
+#include "test/mixed/mixed.h"
+
+#if defined(C_ONLY) || !defined(CXX_ONLY)
+#error "C++ source compiled with the wrong flags"
+#endif
+
+int CxxAnswer() {
+    return CAnswer() + 1;
+}
@@ -0,0 +1,16 @@
+#ifndef TEST_MIXED_MIXED_H
+#define TEST_MIXED_MIXED_H
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+int CAnswer(void);
+
+#ifdef __cplusplus
+}
+
+int CxxAnswer();
+#endif
+
+#endif  // TEST_MIXED_MIXED_H
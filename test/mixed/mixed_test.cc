@@ -0,0 +1,11 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/mixed/mixed.h"
+
+TEST(CAnswer) {
+  CHECK_EQUAL(42, CAnswer());
+}
+
+TEST(CxxAnswer) {
+  CHECK_EQUAL(43, CxxAnswer());
+}
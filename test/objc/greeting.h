@@ -0,0 +1,18 @@
+#ifndef TEST_OBJC_GREETING_H
+#define TEST_OBJC_GREETING_H
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+// Returns the length of a greeting for the given name, built with NSString.
+int greeting_length(const char* name);
+
+// Returns the length of the given string.
+int string_length(const char* s);
+
+#ifdef __cplusplus
+}
+#endif
+
+#endif  // TEST_OBJC_GREETING_H
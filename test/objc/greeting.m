@@ -0,0 +1,8 @@
+#import <Foundation/Foundation.h>
+
+#include "test/objc/greeting.h"
+
+int greeting_length(const char* name) {
+    NSString* greeting = [NSString stringWithFormat:@"Hello, %s!", name];
+    return (int)[greeting length];
+}
@@ -0,0 +1,9 @@
+#include "test/objc/greeting.h"
+
+int string_length(const char* s) {
+    int n = 0;
+    while (s[n]) {
+        ++n;
+    }
+    return n;
+}
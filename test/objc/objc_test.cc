@@ -0,0 +1,12 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/objc/greeting.h"
+
+namespace plz {
+
+TEST(GreetingLength) {
+    CHECK_EQUAL(13, greeting_length("world"));
+    CHECK_EQUAL(5, string_length("world"));
+}
+
+}  // namespace plz
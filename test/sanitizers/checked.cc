@@ -0,0 +1,5 @@
+#include "test/sanitizers/checked.h"
+
+int Shift(int x, int n) {
+  return x << n;
+}
@@ -0,0 +1,6 @@
+#ifndef TEST_SANITIZERS_CHECKED_H
+#define TEST_SANITIZERS_CHECKED_H
+
+int Shift(int x, int n);
+
+#endif  // TEST_SANITIZERS_CHECKED_H
@@ -0,0 +1,7 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/sanitizers/checked.h"
+
+TEST(Shift) {
+  CHECK_EQUAL(40, Shift(5, 3));
+}
@@ -0,0 +1,7 @@
+extern "C" int exported_answer() {
+    return 42;
+}
+
+extern "C" int hidden_answer() {
+    return 43;
+}
@@ -0,0 +1,13 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/lib1.h"
+#include "test/lib2.h"
+
+namespace plz {
+
+TEST(MergedNumbers) {
+    CHECK_EQUAL(107, get_number_1());
+    CHECK_EQUAL(215, get_number_2());
+}
+
+}  // namespace plz
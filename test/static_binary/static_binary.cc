@@ -0,0 +1,8 @@
+#include <thread>
+
+int main() {
+    int answer = 0;
+    std::thread t([&answer]() { answer = 42; });
+    t.join();
+    return answer == 42 ? 0 : 1;
+}
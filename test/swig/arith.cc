@@ -0,0 +1,9 @@
+#include "test/swig/arith.h"
+
+namespace arith {
+
+int add(int a, int b) {
+  return a + b;
+}
+
+}  // namespace arith
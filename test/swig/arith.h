@@ -0,0 +1,10 @@
+#ifndef TEST_SWIG_ARITH_H_
+#define TEST_SWIG_ARITH_H_
+
+namespace arith {
+
+int add(int a, int b);
+
+}  // namespace arith
+
+#endif  // TEST_SWIG_ARITH_H_
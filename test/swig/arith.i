@@ -0,0 +1,5 @@
+%{
+#include "test/swig/arith.h"
+%}
+
+%include "test/swig/arith.h"
@@ -0,0 +1,7 @@
+#include "test/system_libs/cube_root.h"
+
+#include <math.h>
+
+double CubeRoot(double x) {
+    return cbrt(x);
+}
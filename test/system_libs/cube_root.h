@@ -0,0 +1,6 @@
+#ifndef TEST_SYSTEM_LIBS_CUBE_ROOT_H
+#define TEST_SYSTEM_LIBS_CUBE_ROOT_H
+
+double CubeRoot(double x);
+
+#endif  // TEST_SYSTEM_LIBS_CUBE_ROOT_H
@@ -0,0 +1,7 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/system_libs/cube_root.h"
+
+TEST(CubeRoot) {
+  CHECK_CLOSE(3.0, CubeRoot(27.0), 1e-9);
+}
@@ -0,0 +1,9 @@
+#include <UnitTest++/UnitTest++.h>
+
+TEST(BuiltWithToolchain) {
+#ifdef BUILT_WITH_TOOLCHAIN
+  CHECK_EQUAL(1, BUILT_WITH_TOOLCHAIN);
+#else
+  CHECK(false);
+#endif
+}
@@ -0,0 +1,4 @@
+#include "test/whole_archive/registry.h"
+
+// Nothing refers to this, so the linker would drop it if it weren't linked as a whole archive.
+static const bool registered = ++Registered() > 0;
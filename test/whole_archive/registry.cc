@@ -0,0 +1,6 @@
+#include "test/whole_archive/registry.h"
+
+int& Registered() {
+    static int registered = 0;
+    return registered;
+}
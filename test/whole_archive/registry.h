@@ -0,0 +1,7 @@
+#ifndef TEST_WHOLE_ARCHIVE_REGISTRY_H
+#define TEST_WHOLE_ARCHIVE_REGISTRY_H
+
+// Returns the number of plugins that have registered themselves.
+int& Registered();
+
+#endif  // TEST_WHOLE_ARCHIVE_REGISTRY_H
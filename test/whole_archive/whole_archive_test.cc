@@ -0,0 +1,7 @@
+#include <UnitTest++/UnitTest++.h>
+
+#include "test/whole_archive/registry.h"
+
+TEST(PluginRegistered) {
+  CHECK_EQUAL(1, Registered());
+}